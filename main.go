@@ -2,165 +2,148 @@ package main
 
 import (
 	"context"
-	"encoding/json"
 	"flag"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/chromedp/chromedp"
-)
-
-type Product struct {
-	URL         string  `json:"url"`
-	Name        string  `json:"name"`
-	Image       string  `json:"image"`
-	Price       string  `json:"price"`
-	RatingAvg   float64 `json:"rating_avg"`
-	RatingCount int     `json:"rating_count"`
-}
+	"golang.org/x/time/rate"
 
-const (
-	maxProducts = 100
-	urlTemplate = "https://raidlight.com/collections/all?page=%d"
+	"github.com/daadaamed/Vigie-test/scraper"
+	"github.com/daadaamed/Vigie-test/sink"
 )
 
+const maxProducts = 100
+
 func main() {
-	// Option flag: print text or JSON output
-	outputJSON := flag.Bool("json", true, "Output results as JSON")
+	site := flag.String("site", "raidlight", fmt.Sprintf("Site adapter to use (%s)", strings.Join(siteNames(), ", ")))
+	engine := flag.String("engine", "chromedp", "Extraction engine to use (chromedp, goquery)")
+	workers := flag.Int("workers", 1, "Number of pages to scrape concurrently")
+	rps := flag.Float64("rps", 0, "Max page requests per second across all workers (0 = unlimited)")
+	maxRetries := flag.Int("max-retries", 2, "Retries per page on transient failure before giving up")
+	retryBaseDelay := flag.Duration("retry-base-delay", 500*time.Millisecond, "Base delay for retry backoff, doubled on each attempt")
+	out := flag.String("out", "", "Write products to file.ndjson|file.csv|file.db, streamed as pages complete (default: print JSON to stdout)")
+	outputJSON := flag.Bool("json", true, "When printing to stdout (no -out), output JSON instead of plain text")
+	depth := flag.Int("depth", 1, "1 scrapes collection pages only; 2 also visits each product's detail page")
 	flag.Parse()
 
-	ctx, cancel := chromedp.NewContext(context.Background())
+	adapter, ok := scraper.Adapters[*site]
+	if !ok {
+		log.Fatalf("unknown -site %q, available: %s", *site, strings.Join(siteNames(), ", "))
+	}
+
+	extractor, cancel, err := newExtractor(*engine)
+	if err != nil {
+		log.Fatal(err)
+	}
 	defer cancel()
 
-	// Scraping logic
-	products, err := scrapeProducts(ctx, maxProducts)
+	var limiter *rate.Limiter
+	if *rps > 0 {
+		limiter = rate.NewLimiter(rate.Limit(*rps), 1)
+	}
+
+	s, err := newSink(*out, *outputJSON)
 	if err != nil {
-		log.Fatalf("Failed to scrape products: %v", err)
+		log.Fatal(err)
 	}
 
-	outputResults(products, *outputJSON)
-}
+	opts := scraper.Options{
+		MaxProducts: maxProducts,
+		Workers:     *workers,
+		Limiter:     limiter,
+		Retry:       scraper.RetryConfig{MaxRetries: *maxRetries, BaseDelay: *retryBaseDelay},
+	}
 
-// scrapeProducts handles the main scraping logic
-func scrapeProducts(ctx context.Context, maxProducts int) ([]Product, error) {
-	var products []Product
-	page := 1
-	seen := make(map[string]struct{})
+	// -depth=2 needs every product's URL before it can crawl their detail
+	// pages, so it can't stream page-by-page like -depth=1 does.
+	if *depth >= 2 {
+		products, scrapeErr := scraper.ScrapeProducts(extractor, adapter, opts)
+		if scrapeErr != nil {
+			log.Fatalf("Failed to scrape products: %v", scrapeErr)
+		}
 
-	for len(products) < maxProducts {
-		// Scrape each page
-		pageProducts, err := extractProductsFromPage(ctx, page)
+		products, err = scraper.FetchDetails(extractor, adapter, products)
 		if err != nil {
-			return nil, fmt.Errorf("error scraping page %d: %w", page, err)
+			log.Fatalf("Failed to fetch product detail pages: %v", err)
 		}
 
-		if len(pageProducts) == 0 {
-			log.Printf("No products extracted from page %d, might be layout change or end of products", page)
-			break
+		if err := s.Write(products); err != nil {
+			log.Fatalf("Failed to write output: %v", err)
 		}
-
-		productsAdded := addProductsWithoutDuplicates(&products, pageProducts, seen, maxProducts)
-
-		// If we got no new products from this page, we've likely reached the end
-		if productsAdded == 0 && len(products) > 0 {
-			fmt.Printf("No new products found on page %d, stopping\n", page)
-			break
+		if err := s.Close(); err != nil {
+			log.Fatalf("Failed to finalize output: %v", err)
 		}
-
-		page++
+		return
 	}
 
-	return products, nil
+	opts.OnPage = s.Write
+	_, scrapeErr := scraper.ScrapeProducts(extractor, adapter, opts)
+	if err := s.Close(); err != nil {
+		log.Fatalf("Failed to finalize output: %v", err)
+	}
+	if scrapeErr != nil {
+		log.Fatalf("Failed to scrape products: %v", scrapeErr)
+	}
 }
 
-// extractProductsFromPage handles page-level scraping and JavaScript execution
-func extractProductsFromPage(ctx context.Context, page int) ([]Product, error) {
-	var pageProducts []Product
-	url := fmt.Sprintf(urlTemplate, page)
-
-	err := chromedp.Run(ctx,
-		chromedp.Navigate(url),
-		chromedp.WaitReady("body", chromedp.ByQuery),
-		chromedp.WaitVisible(".grid-product", chromedp.ByQuery),
-		chromedp.Evaluate(extractJSContent, &pageProducts),
-	)
-	if err != nil {
-		return nil, fmt.Errorf("failed to navigate or evaluate page %d: %w", page, err)
+// newExtractor builds the Extractor for the given -engine, along with a
+// cleanup func that must be deferred regardless of which engine was chosen.
+func newExtractor(engine string) (scraper.Extractor, func(), error) {
+	switch engine {
+	case "chromedp":
+		ctx, cancel := chromedp.NewContext(context.Background())
+		return scraper.NewChromedpExtractor(ctx), cancel, nil
+	case "goquery":
+		return scraper.NewGoqueryExtractor(), func() {}, nil
+	default:
+		return nil, func() {}, fmt.Errorf("unknown -engine %q, available: chromedp, goquery", engine)
 	}
+}
 
-	return pageProducts, nil
+// newSink returns the sink.Sink for -out, or falls back to the plain-text
+// stdout printer used before -out existed when out is empty and outputJSON
+// is false.
+func newSink(out string, outputJSON bool) (sink.Sink, error) {
+	if out == "" && !outputJSON {
+		return &textSink{}, nil
+	}
+	return sink.New(out)
 }
 
-func addProductsWithoutDuplicates(products *[]Product, pageProducts []Product, seen map[string]struct{}, maxProducts int) int {
-	addedCount := 0
-	for _, product := range pageProducts {
-		if len(*products) >= maxProducts {
-			break
-		}
-		if _, exists := seen[product.URL]; !exists {
-			seen[product.URL] = struct{}{}
-			*products = append(*products, product)
-			addedCount++
-		}
+func siteNames() []string {
+	names := make([]string, 0, len(scraper.Adapters))
+	for name := range scraper.Adapters {
+		names = append(names, name)
 	}
-	return addedCount
+	sort.Strings(names)
+	return names
 }
 
-// outputResults handles the output formatting
-func outputResults(products []Product, outputJSON bool) {
-	if outputJSON {
-		jsonData, err := json.MarshalIndent(products, "", "  ")
-		if err != nil {
-			log.Fatal(err)
-		}
-		fmt.Println(string(jsonData))
-	} else {
-		fmt.Printf("These are the %d products Found :\n\n", len(products))
-		for i, product := range products {
-			if i >= maxProducts {
-				break
-			}
-			fmt.Printf("%d. %s\n", i+1, product.Name)
-			fmt.Printf("   URL: %s\n", product.URL)
-			fmt.Printf("   Price: %s\n", product.Price)
-			if product.RatingCount > 0 {
-				fmt.Printf("   Rating: %.2f/5 (%d reviews)\n", product.RatingAvg, product.RatingCount)
-			}
-			fmt.Printf("   Image: %s\n\n", product.Image)
+// textSink buffers every product and prints them as a human-readable list on
+// Close, matching the plain-text -json=false output from before sinks existed.
+type textSink struct {
+	products []scraper.Product
+}
+
+func (s *textSink) Write(products []scraper.Product) error {
+	s.products = append(s.products, products...)
+	return nil
+}
+
+func (s *textSink) Close() error {
+	fmt.Printf("These are the %d products Found :\n\n", len(s.products))
+	for i, product := range s.products {
+		fmt.Printf("%d. %s\n", i+1, product.Name)
+		fmt.Printf("   URL: %s\n", product.URL)
+		fmt.Printf("   Price: %s\n", product.Price)
+		if product.RatingCount > 0 {
+			fmt.Printf("   Rating: %.2f/5 (%d reviews)\n", product.RatingAvg, product.RatingCount)
 		}
+		fmt.Printf("   Image: %s\n\n", product.Image)
 	}
+	return nil
 }
-
-const extractJSContent = `
-Array.from(document.querySelectorAll('.grid-product')).map(product => {
-  const link = product.querySelector('a.grid-product__link');
-  const nameEl = product.querySelector('.grid-product__title');
-  const imageEl = product.querySelector('.grid__image-ratio, img');
-  const priceEl = product.querySelector('.grid-product__price .money');
-  const ratingEl = product.querySelector('.jdgm-prev-badge');
-
-  // Extract rating info
-  let ratingAvg = 0;
-  let ratingCount = 0;
-  if (ratingEl) {
-    const avgAttr = ratingEl.getAttribute('data-average-rating');
-    const countAttr = ratingEl.getAttribute('data-number-of-reviews');
-    ratingAvg = avgAttr ? parseFloat(avgAttr) : 0;
-    ratingCount = countAttr ? parseInt(countAttr) : 0;
-  }
-
-  // Extract image URL
-  let imageUrl = '';
-  if (imageEl.tagName === 'IMG') {
-	imageUrl = imageEl.src; 
-  }
-
-  return {
-    url: link ? link.href : '',
-    name: nameEl ? nameEl.textContent.trim() : '',
-    image: imageUrl,
-    price: priceEl ? priceEl.textContent.trim() : '',
-    rating_avg: ratingAvg,
-    rating_count: ratingCount
-  };
-}).filter(p => p.url && p.url.includes('/products/'));`
@@ -0,0 +1,185 @@
+package scraper
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func init() {
+	Register(raidlightAdapter{})
+}
+
+// raidlightAdapter scrapes raidlight.com's "collections/all" grid, a stock
+// Shopify theme listing page.
+type raidlightAdapter struct{}
+
+func (raidlightAdapter) Name() string { return "raidlight" }
+
+func (raidlightAdapter) PageURL(page int) string {
+	return fmt.Sprintf("https://raidlight.com/collections/all?page=%d", page)
+}
+
+func (raidlightAdapter) WaitSelector() string { return ".grid-product" }
+
+func (raidlightAdapter) ExtractJS() string { return raidlightExtractJS }
+
+func (raidlightAdapter) Parse(raw []byte) ([]Product, error) {
+	var products []Product
+	if err := json.Unmarshal(raw, &products); err != nil {
+		return nil, fmt.Errorf("raidlight: failed to parse extracted products: %w", err)
+	}
+	return products, nil
+}
+
+// ParseHTML reimplements raidlightExtractJS's selectors for the goquery
+// engine so raidlight can be scraped without a headless browser.
+func (raidlightAdapter) ParseHTML(doc *goquery.Document) ([]Product, error) {
+	var products []Product
+
+	doc.Find(".grid-product").Each(func(_ int, s *goquery.Selection) {
+		href, _ := s.Find("a.grid-product__link").Attr("href")
+		if href == "" || !strings.Contains(href, "/products/") {
+			return
+		}
+		url := ResolveURL(doc, href)
+
+		name := strings.TrimSpace(s.Find(".grid-product__title").First().Text())
+		price := strings.TrimSpace(s.Find(".grid-product__price .money").First().Text())
+
+		imageSrc, _ := s.Find(".grid__image-ratio, img").First().Attr("src")
+		image := ResolveURL(doc, imageSrc)
+
+		var ratingAvg float64
+		var ratingCount int
+		if badge := s.Find(".jdgm-prev-badge").First(); badge.Length() > 0 {
+			if avg, ok := badge.Attr("data-average-rating"); ok {
+				ratingAvg, _ = strconv.ParseFloat(avg, 64)
+			}
+			if count, ok := badge.Attr("data-number-of-reviews"); ok {
+				ratingCount, _ = strconv.Atoi(count)
+			}
+		}
+
+		products = append(products, Product{
+			URL:         url,
+			Name:        name,
+			Image:       image,
+			Price:       price,
+			RatingAvg:   ratingAvg,
+			RatingCount: ratingCount,
+		})
+	})
+
+	return products, nil
+}
+
+func (raidlightAdapter) PathPrefix() string { return "/products/" }
+
+func (raidlightAdapter) DetailWaitSelector() string { return ".product-single" }
+
+func (raidlightAdapter) DetailExtractJS() string { return raidlightDetailExtractJS }
+
+func (raidlightAdapter) ParseDetail(raw []byte) (ProductDetail, error) {
+	var detail ProductDetail
+	if err := json.Unmarshal(raw, &detail); err != nil {
+		return ProductDetail{}, fmt.Errorf("raidlight: failed to parse extracted detail: %w", err)
+	}
+	return detail, nil
+}
+
+// ParseDetailHTML reimplements raidlightDetailExtractJS's selectors for the
+// goquery engine so raidlight detail pages can be scraped without a headless
+// browser.
+func (raidlightAdapter) ParseDetailHTML(doc *goquery.Document) (ProductDetail, error) {
+	detail := ProductDetail{
+		Description: strings.TrimSpace(doc.Find(".product-single__description").First().Text()),
+		SKU:         strings.TrimSpace(doc.Find(".product-single__sku").First().Text()),
+		Stock:       strings.TrimSpace(doc.Find(".product-single__inventory").First().Text()),
+	}
+
+	doc.Find(".single-option-selector option").Each(func(_ int, s *goquery.Selection) {
+		if variant := strings.TrimSpace(s.Text()); variant != "" {
+			detail.Variants = append(detail.Variants, variant)
+		}
+	})
+
+	doc.Find(".breadcrumbs a").Each(func(_ int, s *goquery.Selection) {
+		if crumb := strings.TrimSpace(s.Text()); crumb != "" {
+			detail.Breadcrumbs = append(detail.Breadcrumbs, crumb)
+		}
+	})
+
+	doc.Find(".product-single__photos img").Each(func(_ int, s *goquery.Selection) {
+		if src, ok := s.Attr("src"); ok && src != "" {
+			detail.Images = append(detail.Images, ResolveURL(doc, src))
+		}
+	})
+
+	return detail, nil
+}
+
+const raidlightDetailExtractJS = `
+(() => {
+  const descEl = document.querySelector('.product-single__description');
+  const skuEl = document.querySelector('.product-single__sku');
+  const stockEl = document.querySelector('.product-single__inventory');
+
+  const variants = Array.from(document.querySelectorAll('.single-option-selector option'))
+    .map(o => o.textContent.trim())
+    .filter(Boolean);
+
+  const breadcrumbs = Array.from(document.querySelectorAll('.breadcrumbs a'))
+    .map(a => a.textContent.trim())
+    .filter(Boolean);
+
+  const images = Array.from(document.querySelectorAll('.product-single__photos img'))
+    .map(img => img.src)
+    .filter(Boolean);
+
+  return {
+    description: descEl ? descEl.textContent.trim() : '',
+    sku: skuEl ? skuEl.textContent.trim() : '',
+    stock: stockEl ? stockEl.textContent.trim() : '',
+    variants: variants,
+    breadcrumbs: breadcrumbs,
+    images: images
+  };
+})();`
+
+const raidlightExtractJS = `
+Array.from(document.querySelectorAll('.grid-product')).map(product => {
+  const link = product.querySelector('a.grid-product__link');
+  const nameEl = product.querySelector('.grid-product__title');
+  const imageEl = product.querySelector('.grid__image-ratio, img');
+  const priceEl = product.querySelector('.grid-product__price .money');
+  const ratingEl = product.querySelector('.jdgm-prev-badge');
+
+  // Extract rating info
+  let ratingAvg = 0;
+  let ratingCount = 0;
+  if (ratingEl) {
+    const avgAttr = ratingEl.getAttribute('data-average-rating');
+    const countAttr = ratingEl.getAttribute('data-number-of-reviews');
+    ratingAvg = avgAttr ? parseFloat(avgAttr) : 0;
+    ratingCount = countAttr ? parseInt(countAttr) : 0;
+  }
+
+  // Extract image URL
+  let imageUrl = '';
+  if (imageEl.tagName === 'IMG') {
+	imageUrl = imageEl.src;
+  }
+
+  return {
+    url: link ? link.href : '',
+    name: nameEl ? nameEl.textContent.trim() : '',
+    image: imageUrl,
+    price: priceEl ? priceEl.textContent.trim() : '',
+    rating_avg: ratingAvg,
+    rating_count: ratingCount
+  };
+}).filter(p => p.url && p.url.includes('/products/'));`
@@ -0,0 +1,115 @@
+package scraper
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func mustParseHTML(t *testing.T, rawHTML, pageURL string) *goquery.Document {
+	t.Helper()
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(rawHTML))
+	if err != nil {
+		t.Fatalf("failed to parse test HTML: %v", err)
+	}
+	u, err := url.Parse(pageURL)
+	if err != nil {
+		t.Fatalf("failed to parse test page URL: %v", err)
+	}
+	doc.Url = u
+	return doc
+}
+
+const raidlightGridHTML = `
+<html><body>
+<div class="grid-product">
+  <a class="grid-product__link" href="/products/shoe-1">
+    <img class="grid__image-ratio" src="//cdn.shopify.com/shoe-1.jpg">
+  </a>
+  <div class="grid-product__title">Shoe One</div>
+  <div class="grid-product__price"><span class="money">$100.00</span></div>
+  <div class="jdgm-prev-badge" data-average-rating="4.5" data-number-of-reviews="12"></div>
+</div>
+</body></html>`
+
+func TestRaidlightParseHTMLResolvesRelativeURLs(t *testing.T) {
+	doc := mustParseHTML(t, raidlightGridHTML, "https://raidlight.com/collections/all?page=1")
+
+	products, err := raidlightAdapter{}.ParseHTML(doc)
+	if err != nil {
+		t.Fatalf("ParseHTML returned error: %v", err)
+	}
+	if len(products) != 1 {
+		t.Fatalf("got %d products, want 1", len(products))
+	}
+
+	p := products[0]
+	if p.URL != "https://raidlight.com/products/shoe-1" {
+		t.Errorf("URL = %q, want absolute URL resolved against the page", p.URL)
+	}
+	if p.Image != "https://cdn.shopify.com/shoe-1.jpg" {
+		t.Errorf("Image = %q, want protocol-relative src resolved to https", p.Image)
+	}
+	if p.Name != "Shoe One" {
+		t.Errorf("Name = %q, want %q", p.Name, "Shoe One")
+	}
+	if p.RatingAvg != 4.5 || p.RatingCount != 12 {
+		t.Errorf("RatingAvg/RatingCount = %v/%v, want 4.5/12", p.RatingAvg, p.RatingCount)
+	}
+}
+
+const raidlightDetailHTML = `
+<html><body>
+<div class="product-single__description">A great shoe.</div>
+<div class="product-single__sku">SKU123</div>
+<div class="product-single__inventory">In stock</div>
+<select class="single-option-selector">
+  <option>Red</option>
+  <option>Blue</option>
+</select>
+<div class="breadcrumbs">
+  <a href="/">Home</a>
+  <a href="/collections/shoes">Shoes</a>
+</div>
+<div class="product-single__photos">
+  <img src="/files/shoe-1-alt.jpg">
+  <img src="//cdn.shopify.com/shoe-1-alt2.jpg">
+</div>
+</body></html>`
+
+func TestRaidlightParseDetailHTMLResolvesImageURLs(t *testing.T) {
+	doc := mustParseHTML(t, raidlightDetailHTML, "https://raidlight.com/products/shoe-1")
+
+	detail, err := raidlightAdapter{}.ParseDetailHTML(doc)
+	if err != nil {
+		t.Fatalf("ParseDetailHTML returned error: %v", err)
+	}
+
+	if detail.Description != "A great shoe." {
+		t.Errorf("Description = %q", detail.Description)
+	}
+	if detail.SKU != "SKU123" {
+		t.Errorf("SKU = %q", detail.SKU)
+	}
+	if len(detail.Variants) != 2 || detail.Variants[0] != "Red" || detail.Variants[1] != "Blue" {
+		t.Errorf("Variants = %v, want [Red Blue]", detail.Variants)
+	}
+	if len(detail.Breadcrumbs) != 2 || detail.Breadcrumbs[1] != "Shoes" {
+		t.Errorf("Breadcrumbs = %v", detail.Breadcrumbs)
+	}
+
+	wantImages := []string{
+		"https://raidlight.com/files/shoe-1-alt.jpg",
+		"https://cdn.shopify.com/shoe-1-alt2.jpg",
+	}
+	if len(detail.Images) != len(wantImages) {
+		t.Fatalf("Images = %v, want %v", detail.Images, wantImages)
+	}
+	for i, want := range wantImages {
+		if detail.Images[i] != want {
+			t.Errorf("Images[%d] = %q, want %q", i, detail.Images[i], want)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package scraper
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func init() {
+	Register(wiggleAdapter{})
+}
+
+// wiggleAdapter scrapes wiggle.com's product listing grid. It's a different
+// storefront theme than raidlight's, so the selectors and URL shape differ
+// even though the resulting Product is the same shape.
+type wiggleAdapter struct{}
+
+func (wiggleAdapter) Name() string { return "wiggle" }
+
+func (wiggleAdapter) PageURL(page int) string {
+	return fmt.Sprintf("https://www.wiggle.com/c/running?pageNumber=%d", page)
+}
+
+func (wiggleAdapter) WaitSelector() string { return ".productTile" }
+
+func (wiggleAdapter) ExtractJS() string { return wiggleExtractJS }
+
+func (wiggleAdapter) Parse(raw []byte) ([]Product, error) {
+	var products []Product
+	if err := json.Unmarshal(raw, &products); err != nil {
+		return nil, fmt.Errorf("wiggle: failed to parse extracted products: %w", err)
+	}
+	return products, nil
+}
+
+const wiggleExtractJS = `
+Array.from(document.querySelectorAll('.productTile')).map(product => {
+  const link = product.querySelector('a.productTile-link');
+  const nameEl = product.querySelector('.productTile-title');
+  const imageEl = product.querySelector('img.productTile-image');
+  const priceEl = product.querySelector('.productTile-price .price-amount');
+  const ratingEl = product.querySelector('.productTile-rating');
+
+  let ratingAvg = 0;
+  let ratingCount = 0;
+  if (ratingEl) {
+    const avgAttr = ratingEl.getAttribute('data-average-rating');
+    const countAttr = ratingEl.getAttribute('data-review-count');
+    ratingAvg = avgAttr ? parseFloat(avgAttr) : 0;
+    ratingCount = countAttr ? parseInt(countAttr) : 0;
+  }
+
+  return {
+    url: link ? link.href : '',
+    name: nameEl ? nameEl.textContent.trim() : '',
+    image: imageEl ? imageEl.src : '',
+    price: priceEl ? priceEl.textContent.trim() : '',
+    rating_avg: ratingAvg,
+    rating_count: ratingCount
+  };
+}).filter(p => p.url && p.url.includes('/p/'));`
@@ -0,0 +1,38 @@
+package scraper
+
+import "sync"
+
+// circuitBreakerThreshold is how many consecutive page failures (after
+// retries are exhausted) trip the breaker. It's deliberately not a CLI flag:
+// past this point the site is almost certainly broken rather than just
+// paginated to its end, which instead shows up as an empty page, not an error.
+const circuitBreakerThreshold = 5
+
+// circuitBreaker tracks consecutive page failures across all workers and
+// trips once they reach circuitBreakerThreshold, distinguishing "the site
+// is broken" from "we reached the end of the catalog" (an empty page, which
+// is not a failure; ScrapeProducts records it as a success).
+type circuitBreaker struct {
+	mu          sync.Mutex
+	consecutive int
+	tripped     bool
+}
+
+// recordFailure registers a page failure and reports whether the breaker has
+// tripped.
+func (c *circuitBreaker) recordFailure() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.consecutive++
+	if c.consecutive >= circuitBreakerThreshold {
+		c.tripped = true
+	}
+	return c.tripped
+}
+
+// recordSuccess resets the consecutive failure count.
+func (c *circuitBreaker) recordSuccess() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.consecutive = 0
+}
@@ -0,0 +1,35 @@
+package scraper
+
+import "testing"
+
+func TestCircuitBreakerTripsAtThreshold(t *testing.T) {
+	cb := &circuitBreaker{}
+
+	for i := 0; i < circuitBreakerThreshold-1; i++ {
+		if tripped := cb.recordFailure(); tripped {
+			t.Fatalf("recordFailure() tripped after %d failures, want threshold %d", i+1, circuitBreakerThreshold)
+		}
+	}
+
+	if tripped := cb.recordFailure(); !tripped {
+		t.Fatalf("recordFailure() did not trip after %d consecutive failures", circuitBreakerThreshold)
+	}
+}
+
+func TestCircuitBreakerResetsOnSuccess(t *testing.T) {
+	cb := &circuitBreaker{}
+
+	for i := 0; i < circuitBreakerThreshold-1; i++ {
+		cb.recordFailure()
+	}
+	cb.recordSuccess()
+
+	for i := 0; i < circuitBreakerThreshold-1; i++ {
+		if tripped := cb.recordFailure(); tripped {
+			t.Fatalf("recordFailure() tripped after reset + %d failures, want threshold %d", i+1, circuitBreakerThreshold)
+		}
+	}
+	if tripped := cb.recordFailure(); !tripped {
+		t.Fatalf("recordFailure() did not trip after threshold failures post-reset")
+	}
+}
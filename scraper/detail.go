@@ -0,0 +1,90 @@
+package scraper
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+)
+
+// ProductDetail holds the richer fields only available on a product's own
+// detail page, fetched when -depth=2.
+type ProductDetail struct {
+	Description string   `json:"description"`
+	SKU         string   `json:"sku"`
+	Variants    []string `json:"variants"`
+	Stock       string   `json:"stock"`
+	Breadcrumbs []string `json:"breadcrumbs"`
+	Images      []string `json:"images"`
+}
+
+// DetailAdapter is an optional extension to SiteAdapter for adapters that
+// can scrape a product's detail page with the chromedp engine.
+type DetailAdapter interface {
+	// DetailWaitSelector is the selector chromedp waits on before scraping.
+	DetailWaitSelector() string
+	// DetailExtractJS is the JavaScript evaluated on the detail page.
+	DetailExtractJS() string
+	// ParseDetail converts the raw JSON produced by DetailExtractJS into a ProductDetail.
+	ParseDetail(raw []byte) (ProductDetail, error)
+}
+
+// ScopedDetailAdapter is an optional extension to SiteAdapter for adapters
+// that support detail crawling, regardless of engine. It's kept separate
+// from DetailAdapter/HTMLDetailAdapter (which are engine-specific) so
+// FetchDetails can build a Scope using each adapter's own URL convention
+// instead of a convention borrowed from a single adapter.
+type ScopedDetailAdapter interface {
+	// PathPrefix is the URL path prefix that marks a related link as a
+	// product detail page worth following (e.g. "/products/" for raidlight).
+	PathPrefix() string
+}
+
+// DetailExtractor is an optional extension to Extractor for engines that can
+// fetch a single product's detail page by URL rather than a listing page by
+// number.
+type DetailExtractor interface {
+	ExtractDetail(adapter SiteAdapter, url string) (ProductDetail, error)
+}
+
+// FetchDetails visits the detail page for each product discovered on a
+// primary (collection) page, tagging each as a related link, and attaches a
+// ProductDetail to it. Links outside scope or already visited are skipped;
+// a page that fails to scrape is logged and left without a Detail rather
+// than aborting the rest of the crawl.
+func FetchDetails(extractor Extractor, adapter SiteAdapter, products []Product) ([]Product, error) {
+	detailExtractor, ok := extractor.(DetailExtractor)
+	if !ok {
+		return nil, fmt.Errorf("current engine does not support detail crawling (-depth=2)")
+	}
+
+	scopedAdapter, ok := adapter.(ScopedDetailAdapter)
+	if !ok {
+		return nil, fmt.Errorf("adapter %q does not support detail crawling (-depth=2)", adapter.Name())
+	}
+	scope := NewScope(hostOf(adapter.PageURL(1)), scopedAdapter.PathPrefix())
+
+	for i := range products {
+		url := products[i].URL
+		if !scope.Visit(url) {
+			continue
+		}
+
+		detail, err := detailExtractor.ExtractDetail(adapter, url)
+		if err != nil {
+			log.Printf("failed to fetch %s link %s: %v", LinkRelated, url, err)
+			continue
+		}
+		products[i].Detail = &detail
+	}
+
+	return products, nil
+}
+
+// hostOf returns rawURL's host, or "" if it doesn't parse.
+func hostOf(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return u.Host
+}
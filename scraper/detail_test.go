@@ -0,0 +1,63 @@
+package scraper
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// detailTestAdapter points PageURL at an httptest server so FetchDetails'
+// scope (host + PathPrefix) is derived from a real, but local, listing URL.
+type detailTestAdapter struct {
+	raidlightAdapter
+	baseURL string
+}
+
+func (a detailTestAdapter) PageURL(page int) string {
+	return fmt.Sprintf("%s/collections/all?page=%d", a.baseURL, page)
+}
+
+func TestFetchDetailsIntegration(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/products/in-scope", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, raidlightDetailHTML)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	adapter := detailTestAdapter{baseURL: server.URL}
+	extractor := goqueryExtractor{client: server.Client()}
+
+	products := []Product{
+		{URL: server.URL + "/products/in-scope"},
+		{URL: "https://other-host.example/products/out-of-scope"},
+		{URL: server.URL + "/p/wrong-prefix"},
+	}
+
+	got, err := FetchDetails(extractor, adapter, products)
+	if err != nil {
+		t.Fatalf("FetchDetails returned error: %v", err)
+	}
+
+	if got[0].Detail == nil {
+		t.Errorf("in-scope product has no Detail, want it fetched")
+	} else if got[0].Detail.SKU != "SKU123" {
+		t.Errorf("in-scope product Detail.SKU = %q, want SKU123", got[0].Detail.SKU)
+	}
+	if got[1].Detail != nil {
+		t.Errorf("out-of-scope (wrong host) product got a Detail, want none")
+	}
+	if got[2].Detail != nil {
+		t.Errorf("out-of-scope (wrong path prefix) product got a Detail, want none")
+	}
+}
+
+func TestFetchDetailsRequiresScopedAdapter(t *testing.T) {
+	extractor := goqueryExtractor{client: http.DefaultClient}
+
+	_, err := FetchDetails(extractor, fakeAdapter{}, []Product{{URL: "https://fake.example/products/1"}})
+	if err == nil {
+		t.Fatal("FetchDetails returned nil error for an adapter without ScopedDetailAdapter, want an error")
+	}
+}
@@ -0,0 +1,68 @@
+package scraper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/chromedp/chromedp"
+)
+
+// chromedpExtractor renders each page in a headless browser before scraping
+// it, which is required for storefronts that build their product grid with
+// client-side JavaScript.
+type chromedpExtractor struct {
+	ctx context.Context
+}
+
+// NewChromedpExtractor returns an Extractor that drives chromedp against ctx,
+// which should come from chromedp.NewContext.
+func NewChromedpExtractor(ctx context.Context) Extractor {
+	return chromedpExtractor{ctx: ctx}
+}
+
+// NewWorker opens a new browser tab under e's context so concurrent workers
+// each get their own tab instead of racing to navigate a shared one.
+func (e chromedpExtractor) NewWorker() (Extractor, func(), error) {
+	tabCtx, cancel := chromedp.NewContext(e.ctx)
+	return chromedpExtractor{ctx: tabCtx}, cancel, nil
+}
+
+func (e chromedpExtractor) ExtractPage(adapter SiteAdapter, page int) ([]Product, error) {
+	var raw json.RawMessage
+	url := adapter.PageURL(page)
+
+	err := chromedp.Run(e.ctx,
+		chromedp.Navigate(url),
+		chromedp.WaitReady("body", chromedp.ByQuery),
+		chromedp.WaitVisible(adapter.WaitSelector(), chromedp.ByQuery),
+		chromedp.Evaluate(adapter.ExtractJS(), &raw),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to navigate or evaluate page %d: %w", page, err)
+	}
+
+	return adapter.Parse(raw)
+}
+
+// ExtractDetail fetches a product's own detail page, required for -depth=2.
+// It requires adapter to implement DetailAdapter.
+func (e chromedpExtractor) ExtractDetail(adapter SiteAdapter, url string) (ProductDetail, error) {
+	detailAdapter, ok := adapter.(DetailAdapter)
+	if !ok {
+		return ProductDetail{}, fmt.Errorf("adapter %q does not support detail crawling", adapter.Name())
+	}
+
+	var raw json.RawMessage
+	err := chromedp.Run(e.ctx,
+		chromedp.Navigate(url),
+		chromedp.WaitReady("body", chromedp.ByQuery),
+		chromedp.WaitVisible(detailAdapter.DetailWaitSelector(), chromedp.ByQuery),
+		chromedp.Evaluate(detailAdapter.DetailExtractJS(), &raw),
+	)
+	if err != nil {
+		return ProductDetail{}, fmt.Errorf("failed to navigate or evaluate detail page %s: %w", url, err)
+	}
+
+	return detailAdapter.ParseDetail(raw)
+}
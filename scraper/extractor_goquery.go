@@ -0,0 +1,106 @@
+package scraper
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// HTMLAdapter is an optional extension to SiteAdapter for storefronts that
+// render their product grid server-side, so it can be scraped with a plain
+// HTTP GET instead of a headless browser. Adapters that don't implement it
+// can still be driven by the chromedp extractor.
+type HTMLAdapter interface {
+	// ParseHTML extracts Products from a parsed listing page.
+	ParseHTML(doc *goquery.Document) ([]Product, error)
+}
+
+// HTMLDetailAdapter is an optional extension to SiteAdapter for storefronts
+// whose detail pages can also be scraped with goquery, required for
+// -depth=2 -engine=goquery.
+type HTMLDetailAdapter interface {
+	// ParseDetailHTML extracts a ProductDetail from a parsed detail page.
+	ParseDetailHTML(doc *goquery.Document) (ProductDetail, error)
+}
+
+// goqueryExtractor fetches each page with net/http and parses it with
+// goquery, avoiding the cost of a headless browser for sites that don't need
+// one.
+type goqueryExtractor struct {
+	client *http.Client
+}
+
+// NewGoqueryExtractor returns an Extractor backed by net/http and goquery.
+// It only works with adapters that implement HTMLAdapter.
+func NewGoqueryExtractor() Extractor {
+	return goqueryExtractor{client: http.DefaultClient}
+}
+
+func (e goqueryExtractor) ExtractPage(adapter SiteAdapter, page int) ([]Product, error) {
+	htmlAdapter, ok := adapter.(HTMLAdapter)
+	if !ok {
+		return nil, fmt.Errorf("adapter %q does not support the goquery engine", adapter.Name())
+	}
+
+	url := adapter.PageURL(page)
+	resp, err := e.client.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch page %d: %w", page, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch page %d: unexpected status %s", page, resp.Status)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse page %d: %w", page, err)
+	}
+	doc.Url = resp.Request.URL
+
+	return htmlAdapter.ParseHTML(doc)
+}
+
+// ExtractDetail fetches a product's own detail page with net/http, required
+// for -depth=2. It requires adapter to implement HTMLDetailAdapter.
+func (e goqueryExtractor) ExtractDetail(adapter SiteAdapter, url string) (ProductDetail, error) {
+	htmlAdapter, ok := adapter.(HTMLDetailAdapter)
+	if !ok {
+		return ProductDetail{}, fmt.Errorf("adapter %q does not support goquery detail crawling", adapter.Name())
+	}
+
+	resp, err := e.client.Get(url)
+	if err != nil {
+		return ProductDetail{}, fmt.Errorf("failed to fetch detail page %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return ProductDetail{}, fmt.Errorf("failed to fetch detail page %s: unexpected status %s", url, resp.Status)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	if err != nil {
+		return ProductDetail{}, fmt.Errorf("failed to parse detail page %s: %w", url, err)
+	}
+	doc.Url = resp.Request.URL
+
+	return htmlAdapter.ParseDetailHTML(doc)
+}
+
+// ResolveURL resolves ref (typically an <a href> or <img src> pulled
+// straight off the DOM, which may be relative or protocol-relative) against
+// the page doc was fetched from, so goquery-parsed URLs are absolute just
+// like the ones chromedp gets from the browser's DOM (e.g. link.href).
+func ResolveURL(doc *goquery.Document, ref string) string {
+	if ref == "" || doc.Url == nil {
+		return ref
+	}
+	resolved, err := doc.Url.Parse(ref)
+	if err != nil {
+		return ref
+	}
+	return resolved.String()
+}
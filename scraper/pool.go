@@ -0,0 +1,189 @@
+package scraper
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// WorkerFactory lets an Extractor hand out one independent instance per
+// worker goroutine (for example a separate chromedp tab per worker).
+// Extractors that don't implement it are shared across all workers, which is
+// fine for extractors like the goquery one whose underlying client already
+// supports concurrent use.
+type WorkerFactory interface {
+	NewWorker() (Extractor, func(), error)
+}
+
+type pageResult struct {
+	page     int
+	products []Product
+	err      error
+}
+
+// Options configures ScrapeProducts.
+type Options struct {
+	// MaxProducts is the number of distinct products to collect before stopping.
+	MaxProducts int
+	// Workers is how many pages to scrape concurrently. Values below 1 are
+	// treated as 1.
+	Workers int
+	// Limiter, if non-nil, caps page requests per second across all workers.
+	Limiter *rate.Limiter
+	// Retry controls per-page retry behavior on transient failures.
+	Retry RetryConfig
+	// OnPage, if non-nil, is called with the newly discovered (deduplicated)
+	// products as each page completes, so callers can stream results to a
+	// sink instead of waiting for the whole scrape to finish. A returned
+	// error stops the scrape.
+	OnPage func([]Product) error
+}
+
+// ScrapeProducts walks pages of the given adapter using a pool of workers,
+// collecting up to opts.MaxProducts distinct products (by URL). Workers pull
+// page numbers from a shared channel and stop as soon as MaxProducts is
+// reached, any worker sees an empty page (end of catalog), or the circuit
+// breaker trips after too many consecutive page failures (site is broken).
+func ScrapeProducts(extractor Extractor, adapter SiteAdapter, opts Options) ([]Product, error) {
+	workers := opts.Workers
+	if workers < 1 {
+		workers = 1
+	}
+
+	links := make(chan int)
+	results := make(chan pageResult)
+	stop := make(chan struct{})
+	var stopOnce sync.Once
+	requestStop := func() { stopOnce.Do(func() { close(stop) }) }
+	breaker := &circuitBreaker{}
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			runWorker(extractor, adapter, links, results, stop, opts.Limiter, opts.Retry)
+		}()
+	}
+
+	go func() {
+		defer close(links)
+		for page := 1; ; page++ {
+			select {
+			case <-stop:
+				return
+			case links <- page:
+			}
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	var (
+		mu       sync.Mutex
+		products []Product
+		seen     = make(map[string]struct{})
+	)
+
+	var firstErr error
+	for res := range results {
+		if res.err != nil {
+			log.Printf("error scraping page %d: %v", res.page, res.err)
+			firstErr = res.err
+			if breaker.recordFailure() {
+				log.Printf("circuit breaker tripped after %d consecutive page failures, stopping", circuitBreakerThreshold)
+				requestStop()
+			}
+			continue
+		}
+		breaker.recordSuccess()
+
+		if len(res.products) == 0 {
+			log.Printf("No products extracted from page %d, might be layout change or end of products", res.page)
+			requestStop()
+			continue
+		}
+
+		mu.Lock()
+		before := len(products)
+		added := addProductsWithoutDuplicates(&products, res.products, seen, opts.MaxProducts)
+		newProducts := append([]Product(nil), products[before:]...)
+		done := len(products) >= opts.MaxProducts
+		mu.Unlock()
+
+		if added == 0 {
+			fmt.Printf("No new products found on page %d, stopping\n", res.page)
+			requestStop()
+		}
+
+		if opts.OnPage != nil && len(newProducts) > 0 {
+			if err := opts.OnPage(newProducts); err != nil {
+				log.Printf("sink failed to persist page %d: %v", res.page, err)
+				firstErr = err
+				requestStop()
+			}
+		}
+
+		if done {
+			requestStop()
+		}
+	}
+
+	if len(products) == 0 && firstErr != nil {
+		return nil, firstErr
+	}
+	return products, nil
+}
+
+// runWorker owns a single Extractor (its own chromedp tab, if the extractor
+// is a WorkerFactory) and scrapes pages from links until stop is closed.
+func runWorker(shared Extractor, adapter SiteAdapter, links <-chan int, results chan<- pageResult, stop <-chan struct{}, limiter *rate.Limiter, retry RetryConfig) {
+	extractor := shared
+	cleanup := func() {}
+	if factory, ok := shared.(WorkerFactory); ok {
+		we, c, err := factory.NewWorker()
+		if err != nil {
+			log.Printf("failed to set up worker: %v", err)
+			return
+		}
+		extractor, cleanup = we, c
+	}
+	defer cleanup()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case page, ok := <-links:
+			if !ok {
+				return
+			}
+
+			if limiter != nil {
+				if err := limiter.Wait(context.Background()); err != nil {
+					sendResult(results, pageResult{page: page, err: err})
+					continue
+				}
+			}
+
+			products, err := extractPageWithRetry(extractor, adapter, page, retry)
+			sendResult(results, pageResult{page: page, products: products, err: err})
+		}
+	}
+}
+
+// sendResult always delivers res to results, even after stop has been
+// closed: the consumer keeps ranging over results until every worker has
+// returned and results is closed (see ScrapeProducts), so a blocking send
+// here can never deadlock. Racing the send against stop would let an
+// in-flight page's result be silently dropped whenever stop happened to
+// close at the same instant the consumer was ready to receive.
+func sendResult(results chan<- pageResult, res pageResult) {
+	results <- res
+}
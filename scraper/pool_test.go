@@ -0,0 +1,62 @@
+package scraper
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+type fakeAdapter struct{}
+
+func (fakeAdapter) Name() string                        { return "fake" }
+func (fakeAdapter) PageURL(page int) string             { return fmt.Sprintf("https://fake.example/%d", page) }
+func (fakeAdapter) WaitSelector() string                { return "" }
+func (fakeAdapter) ExtractJS() string                   { return "" }
+func (fakeAdapter) Parse(raw []byte) ([]Product, error) { return nil, nil }
+
+// slowFirstPageExtractor returns a real product for page 1, but only after
+// release is closed, and an empty (end-of-catalog) result for every other
+// page immediately. This reproduces a worker finishing a genuinely new page
+// after a different worker has already triggered requestStop.
+type slowFirstPageExtractor struct {
+	release chan struct{}
+}
+
+func (e *slowFirstPageExtractor) ExtractPage(adapter SiteAdapter, page int) ([]Product, error) {
+	if page == 1 {
+		<-e.release
+		return []Product{{URL: "https://fake.example/products/1", Name: "only product"}}, nil
+	}
+	return nil, nil
+}
+
+func TestScrapeProductsDoesNotDropInFlightResult(t *testing.T) {
+	release := make(chan struct{})
+	extractor := &slowFirstPageExtractor{release: release}
+
+	done := make(chan struct{})
+	var products []Product
+	var err error
+	go func() {
+		products, err = ScrapeProducts(extractor, fakeAdapter{}, Options{MaxProducts: 100, Workers: 2})
+		close(done)
+	}()
+
+	// Give the page-2 worker time to return its empty result and call
+	// requestStop before page 1's (genuinely new) product is released.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("ScrapeProducts did not return in time")
+	}
+
+	if err != nil {
+		t.Fatalf("ScrapeProducts returned error: %v", err)
+	}
+	if len(products) != 1 {
+		t.Fatalf("products = %v, want 1 product (page 1's result was dropped)", products)
+	}
+}
@@ -0,0 +1,52 @@
+package scraper
+
+import (
+	"fmt"
+	"log"
+	"math/rand"
+	"time"
+)
+
+// RetryConfig controls how a page's transient failures are retried before
+// the page is reported as failed.
+type RetryConfig struct {
+	// MaxRetries is the number of retries attempted after the first try
+	// (so a page can be fetched up to MaxRetries+1 times). 0 disables retries.
+	MaxRetries int
+	// BaseDelay is the starting backoff delay; it doubles on each retry and
+	// gets a random jitter of up to BaseDelay added on top.
+	BaseDelay time.Duration
+}
+
+// extractPageWithRetry calls extractor.ExtractPage, retrying on error with
+// exponential backoff and jitter, and logging each failed attempt.
+func extractPageWithRetry(extractor Extractor, adapter SiteAdapter, page int, cfg RetryConfig) ([]Product, error) {
+	var lastErr error
+	for attempt := 0; attempt <= cfg.MaxRetries; attempt++ {
+		products, err := extractor.ExtractPage(adapter, page)
+		if err == nil {
+			return products, nil
+		}
+		lastErr = err
+
+		if attempt == cfg.MaxRetries {
+			break
+		}
+
+		delay := backoffDelay(cfg.BaseDelay, attempt)
+		log.Printf("attempt %d/%d failed for page %d: %v, retrying in %s", attempt+1, cfg.MaxRetries+1, page, err, delay)
+		time.Sleep(delay)
+	}
+
+	return nil, fmt.Errorf("page %d failed after %d attempts: %w", page, cfg.MaxRetries+1, lastErr)
+}
+
+// backoffDelay returns base*2^attempt plus a random jitter in [0, base].
+func backoffDelay(base time.Duration, attempt int) time.Duration {
+	if base <= 0 {
+		return 0
+	}
+	backoff := base * time.Duration(uint64(1)<<uint(attempt))
+	jitter := time.Duration(rand.Int63n(int64(base) + 1))
+	return backoff + jitter
+}
@@ -0,0 +1,45 @@
+package scraper
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBackoffDelayZeroBase(t *testing.T) {
+	if got := backoffDelay(0, 3); got != 0 {
+		t.Errorf("backoffDelay(0, 3) = %v, want 0", got)
+	}
+}
+
+func TestBackoffDelayBounds(t *testing.T) {
+	base := 100 * time.Millisecond
+	for attempt := 0; attempt < 5; attempt++ {
+		backoff := base * time.Duration(uint64(1)<<uint(attempt))
+		min, max := backoff, backoff+base
+
+		for i := 0; i < 20; i++ {
+			got := backoffDelay(base, attempt)
+			if got < min || got > max {
+				t.Fatalf("backoffDelay(%v, %d) = %v, want in [%v, %v]", base, attempt, got, min, max)
+			}
+		}
+	}
+}
+
+func TestBackoffDelayDoublesPerAttempt(t *testing.T) {
+	base := 50 * time.Millisecond
+	// The minimum possible delay (no jitter) should double each attempt.
+	for attempt := 0; attempt < 4; attempt++ {
+		want := base * time.Duration(uint64(1)<<uint(attempt))
+		var min time.Duration = -1
+		for i := 0; i < 50; i++ {
+			got := backoffDelay(base, attempt)
+			if min == -1 || got < min {
+				min = got
+			}
+		}
+		if min < want {
+			t.Errorf("attempt %d: observed minimum %v below expected floor %v", attempt, min, want)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package scraper
+
+import (
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// LinkKind tags where a URL came from during a crawl: the collection page
+// itself (primary) or a product link discovered on it (related).
+type LinkKind int
+
+const (
+	LinkPrimary LinkKind = iota
+	LinkRelated
+)
+
+func (k LinkKind) String() string {
+	if k == LinkPrimary {
+		return "primary"
+	}
+	return "related"
+}
+
+// Scope decides whether a related link discovered on a primary page is worth
+// following: same host, under pathPrefix, and not already visited.
+type Scope struct {
+	host       string
+	pathPrefix string
+
+	mu   sync.Mutex
+	seen map[string]struct{}
+}
+
+// NewScope returns a Scope admitting only URLs on host under pathPrefix.
+func NewScope(host, pathPrefix string) *Scope {
+	return &Scope{host: host, pathPrefix: pathPrefix, seen: make(map[string]struct{})}
+}
+
+// Visit reports whether rawURL is in scope and hasn't been visited before,
+// marking it visited if so.
+func (s *Scope) Visit(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host != s.host || !strings.HasPrefix(u.Path, s.pathPrefix) {
+		return false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.seen[rawURL]; ok {
+		return false
+	}
+	s.seen[rawURL] = struct{}{}
+	return true
+}
@@ -0,0 +1,38 @@
+package scraper
+
+import "testing"
+
+func TestScopeVisit(t *testing.T) {
+	tests := []struct {
+		name string
+		url  string
+		want bool
+	}{
+		{"in scope", "https://example.com/products/shoe", true},
+		{"wrong host", "https://other.com/products/shoe", false},
+		{"wrong path prefix", "https://example.com/p/shoe", false},
+		{"unparsable url", "://bad-url", false},
+		{"no scheme", "example.com/products/shoe", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewScope("example.com", "/products/")
+			if got := s.Visit(tt.url); got != tt.want {
+				t.Errorf("Visit(%q) = %v, want %v", tt.url, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScopeVisitDeduplicates(t *testing.T) {
+	s := NewScope("example.com", "/products/")
+	const url = "https://example.com/products/shoe"
+
+	if !s.Visit(url) {
+		t.Fatalf("Visit(%q) = false on first visit, want true", url)
+	}
+	if s.Visit(url) {
+		t.Fatalf("Visit(%q) = true on second visit, want false (already seen)", url)
+	}
+}
@@ -0,0 +1,70 @@
+// Package scraper implements the core scraping loop used to walk a paginated
+// storefront and collect products. Site-specific behavior (URLs, selectors,
+// extraction JS) is provided by a SiteAdapter so new storefronts can be added
+// without touching the loop itself. How a page is fetched and parsed is
+// provided by an Extractor, so the same adapter can be driven by chromedp or
+// by a lighter-weight HTTP+goquery pipeline.
+package scraper
+
+// Product is the normalized record produced by every adapter. Detail is only
+// populated when the CLI is run with -depth=2.
+type Product struct {
+	URL         string         `json:"url"`
+	Name        string         `json:"name"`
+	Image       string         `json:"image"`
+	Price       string         `json:"price"`
+	RatingAvg   float64        `json:"rating_avg"`
+	RatingCount int            `json:"rating_count"`
+	Detail      *ProductDetail `json:"detail,omitempty"`
+}
+
+// SiteAdapter encapsulates everything that differs between storefronts: the
+// per-page URL, the selector to wait on before scraping, the extraction JS to
+// run in-page, and how to turn the raw result into Products.
+type SiteAdapter interface {
+	// Name identifies the adapter for the -site flag and log output.
+	Name() string
+	// PageURL returns the URL for the given 1-indexed page.
+	PageURL(page int) string
+	// WaitSelector is the CSS selector chromedp waits to become visible
+	// before the page is considered ready to scrape.
+	WaitSelector() string
+	// ExtractJS is the JavaScript evaluated in-page to collect raw product
+	// data. It must return an array of objects matching Parse's expectations.
+	ExtractJS() string
+	// Parse converts the raw JSON produced by ExtractJS into Products.
+	Parse(raw []byte) ([]Product, error)
+}
+
+// Adapters holds every adapter available to the CLI, keyed by the name
+// passed to -site.
+var Adapters = map[string]SiteAdapter{}
+
+// Register adds an adapter to Adapters. Adapters call this from an init()
+// function so importing the scraper package is enough to make them available.
+func Register(a SiteAdapter) {
+	Adapters[a.Name()] = a
+}
+
+// Extractor fetches and parses a single page for the given adapter. The
+// chromedp implementation renders the page with a headless browser; the
+// goquery implementation fetches over plain HTTP, which is much cheaper for
+// storefronts that render products server-side.
+type Extractor interface {
+	ExtractPage(adapter SiteAdapter, page int) ([]Product, error)
+}
+
+func addProductsWithoutDuplicates(products *[]Product, pageProducts []Product, seen map[string]struct{}, maxProducts int) int {
+	addedCount := 0
+	for _, product := range pageProducts {
+		if len(*products) >= maxProducts {
+			break
+		}
+		if _, exists := seen[product.URL]; !exists {
+			seen[product.URL] = struct{}{}
+			*products = append(*products, product)
+			addedCount++
+		}
+	}
+	return addedCount
+}
@@ -0,0 +1,45 @@
+package scraper
+
+import "testing"
+
+func TestAddProductsWithoutDuplicates(t *testing.T) {
+	products := []Product{{URL: "https://example.com/a"}}
+	seen := map[string]struct{}{"https://example.com/a": {}}
+
+	page := []Product{
+		{URL: "https://example.com/a"}, // duplicate, should be skipped
+		{URL: "https://example.com/b"},
+		{URL: "https://example.com/c"},
+	}
+
+	added := addProductsWithoutDuplicates(&products, page, seen, 100)
+
+	if added != 2 {
+		t.Errorf("addedCount = %d, want 2", added)
+	}
+	if len(products) != 3 {
+		t.Errorf("len(products) = %d, want 3", len(products))
+	}
+}
+
+func TestAddProductsWithoutDuplicatesRespectsMax(t *testing.T) {
+	products := []Product{{URL: "https://example.com/a"}, {URL: "https://example.com/b"}}
+	seen := map[string]struct{}{
+		"https://example.com/a": {},
+		"https://example.com/b": {},
+	}
+
+	page := []Product{
+		{URL: "https://example.com/c"},
+		{URL: "https://example.com/d"},
+	}
+
+	added := addProductsWithoutDuplicates(&products, page, seen, 2)
+
+	if added != 0 {
+		t.Errorf("addedCount = %d, want 0 (already at maxProducts)", added)
+	}
+	if len(products) != 2 {
+		t.Errorf("len(products) = %d, want 2 (unchanged)", len(products))
+	}
+}
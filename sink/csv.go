@@ -0,0 +1,68 @@
+package sink
+
+import (
+	"encoding/csv"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/daadaamed/Vigie-test/scraper"
+)
+
+var csvHeader = []string{"url", "name", "image", "price", "rating_avg", "rating_count"}
+
+// csvSink writes one row per product, flushing to disk as each page
+// completes. It has no column for Product.Detail (-depth=2); use .ndjson or
+// .json if you need that data.
+type csvSink struct {
+	f            *os.File
+	w            *csv.Writer
+	warnedDetail bool
+}
+
+func newCSVSink(path string) (*csvSink, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("csv sink: %w", err)
+	}
+
+	w := csv.NewWriter(f)
+	if err := w.Write(csvHeader); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("csv sink: %w", err)
+	}
+
+	return &csvSink{f: f, w: w}, nil
+}
+
+func (s *csvSink) Write(products []scraper.Product) error {
+	for _, p := range products {
+		if p.Detail != nil && !s.warnedDetail {
+			log.Printf("csv sink: -depth=2 detail fields have no CSV column and will not be written; use .ndjson or .json to keep them")
+			s.warnedDetail = true
+		}
+
+		row := []string{
+			p.URL,
+			p.Name,
+			p.Image,
+			p.Price,
+			strconv.FormatFloat(p.RatingAvg, 'f', -1, 64),
+			strconv.Itoa(p.RatingCount),
+		}
+		if err := s.w.Write(row); err != nil {
+			return fmt.Errorf("csv sink: %w", err)
+		}
+	}
+	s.w.Flush()
+	return s.w.Error()
+}
+
+func (s *csvSink) Close() error {
+	s.w.Flush()
+	if err := s.w.Error(); err != nil {
+		return fmt.Errorf("csv sink: %w", err)
+	}
+	return s.f.Close()
+}
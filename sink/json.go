@@ -0,0 +1,42 @@
+package sink
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/daadaamed/Vigie-test/scraper"
+)
+
+// jsonSink buffers every product and writes them as a single pretty-printed
+// JSON array on Close, either to a file or, if path is empty, to stdout.
+type jsonSink struct {
+	path     string
+	products []scraper.Product
+}
+
+func newJSONSink(path string) *jsonSink {
+	return &jsonSink{path: path}
+}
+
+func (s *jsonSink) Write(products []scraper.Product) error {
+	s.products = append(s.products, products...)
+	return nil
+}
+
+func (s *jsonSink) Close() error {
+	data, err := json.MarshalIndent(s.products, "", "  ")
+	if err != nil {
+		return fmt.Errorf("json sink: %w", err)
+	}
+
+	if s.path == "" {
+		fmt.Println(string(data))
+		return nil
+	}
+
+	if err := os.WriteFile(s.path, data, 0o644); err != nil {
+		return fmt.Errorf("json sink: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package sink
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/daadaamed/Vigie-test/scraper"
+)
+
+// ndjsonSink writes one JSON object per product, flushing to disk as each
+// page completes so a long-running scrape can be tailed or resumed with
+// tools like jq.
+type ndjsonSink struct {
+	f   *os.File
+	enc *json.Encoder
+}
+
+func newNDJSONSink(path string) (*ndjsonSink, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("ndjson sink: %w", err)
+	}
+	return &ndjsonSink{f: f, enc: json.NewEncoder(f)}, nil
+}
+
+func (s *ndjsonSink) Write(products []scraper.Product) error {
+	for _, p := range products {
+		if err := s.enc.Encode(p); err != nil {
+			return fmt.Errorf("ndjson sink: %w", err)
+		}
+	}
+	return s.f.Sync()
+}
+
+func (s *ndjsonSink) Close() error {
+	return s.f.Close()
+}
@@ -0,0 +1,40 @@
+// Package sink persists scraped products to disk in a few formats, chosen by
+// the output file's extension.
+package sink
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/daadaamed/Vigie-test/scraper"
+)
+
+// Sink persists scraped products as they're discovered. Write may be called
+// many times as pages complete; Close finalizes the output (flushing
+// buffers, writing a closing JSON bracket, closing a file or DB handle).
+type Sink interface {
+	Write(products []scraper.Product) error
+	Close() error
+}
+
+// New returns the Sink for path, chosen by its extension:
+// .ndjson -> newline-delimited JSON, streamed as Write is called
+// .csv    -> CSV, streamed as Write is called
+// .db     -> SQLite, upserted into a products table keyed on URL
+// .json or no extension -> pretty JSON, written on Close (to stdout if path is empty)
+// any other extension is an error.
+func New(path string) (Sink, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".ndjson":
+		return newNDJSONSink(path)
+	case ".csv":
+		return newCSVSink(path)
+	case ".db":
+		return newSQLiteSink(path)
+	case "", ".json":
+		return newJSONSink(path), nil
+	default:
+		return nil, fmt.Errorf("sink: unrecognized -out extension %q (want .ndjson, .csv, .db, or .json)", filepath.Ext(path))
+	}
+}
@@ -0,0 +1,213 @@
+package sink
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/daadaamed/Vigie-test/scraper"
+)
+
+var testProducts = []scraper.Product{
+	{
+		URL:         "https://example.com/products/a",
+		Name:        "Product A",
+		Image:       "https://example.com/a.jpg",
+		Price:       "$10.00",
+		RatingAvg:   4.5,
+		RatingCount: 3,
+	},
+	{
+		URL:         "https://example.com/products/b",
+		Name:        "Product B",
+		Image:       "https://example.com/b.jpg",
+		Price:       "$20.00",
+		RatingAvg:   3.0,
+		RatingCount: 1,
+		Detail:      &scraper.ProductDetail{Description: "has detail"},
+	},
+}
+
+func TestNewDispatchesByExtension(t *testing.T) {
+	dir := t.TempDir()
+
+	cases := []struct {
+		path    string
+		wantErr bool
+	}{
+		{filepath.Join(dir, "out.json"), false},
+		{filepath.Join(dir, "out.ndjson"), false},
+		{filepath.Join(dir, "out.csv"), false},
+		{filepath.Join(dir, "out.db"), false},
+		{"", false},
+		{filepath.Join(dir, "out.xml"), true},
+	}
+
+	for _, c := range cases {
+		s, err := New(c.path)
+		if c.wantErr {
+			if err == nil {
+				t.Errorf("New(%q) returned nil error, want one", c.path)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("New(%q) returned error: %v", c.path, err)
+			continue
+		}
+		if err := s.Close(); err != nil {
+			t.Errorf("New(%q).Close() returned error: %v", c.path, err)
+		}
+	}
+}
+
+func TestJSONSinkRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.json")
+	s := newJSONSink(path)
+
+	if err := s.Write(testProducts); err != nil {
+		t.Fatalf("Write returned error: %v", err)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close returned error: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read output file: %v", err)
+	}
+
+	var got []scraper.Product
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("failed to unmarshal output: %v", err)
+	}
+	if len(got) != len(testProducts) {
+		t.Fatalf("got %d products, want %d", len(got), len(testProducts))
+	}
+	if got[1].Detail == nil || got[1].Detail.Description != "has detail" {
+		t.Errorf("JSON sink lost the Detail field: %+v", got[1])
+	}
+}
+
+func TestNDJSONSinkRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.ndjson")
+	s, err := newNDJSONSink(path)
+	if err != nil {
+		t.Fatalf("newNDJSONSink returned error: %v", err)
+	}
+
+	if err := s.Write(testProducts); err != nil {
+		t.Fatalf("Write returned error: %v", err)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close returned error: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read output file: %v", err)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	var got []scraper.Product
+	for {
+		var p scraper.Product
+		if err := dec.Decode(&p); err != nil {
+			break
+		}
+		got = append(got, p)
+	}
+	if len(got) != len(testProducts) {
+		t.Fatalf("got %d products, want %d", len(got), len(testProducts))
+	}
+	if got[1].Detail == nil || got[1].Detail.Description != "has detail" {
+		t.Errorf("NDJSON sink lost the Detail field: %+v", got[1])
+	}
+}
+
+func TestCSVSinkRoundTripAndDetailWarning(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.csv")
+	s, err := newCSVSink(path)
+	if err != nil {
+		t.Fatalf("newCSVSink returned error: %v", err)
+	}
+
+	if err := s.Write(testProducts); err != nil {
+		t.Fatalf("Write returned error: %v", err)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close returned error: %v", err)
+	}
+	if !s.warnedDetail {
+		t.Error("csvSink did not set warnedDetail after writing a product with Detail set")
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("failed to open output file: %v", err)
+	}
+	defer f.Close()
+
+	rows, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		t.Fatalf("failed to read CSV: %v", err)
+	}
+	// header + one row per product
+	if len(rows) != len(testProducts)+1 {
+		t.Fatalf("got %d CSV rows, want %d", len(rows), len(testProducts)+1)
+	}
+	if rows[1][1] != "Product A" {
+		t.Errorf("row 1 name = %q, want %q", rows[1][1], "Product A")
+	}
+}
+
+func TestSQLiteSinkRoundTripAndDetailWarning(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.db")
+	s, err := newSQLiteSink(path)
+	if err != nil {
+		t.Fatalf("newSQLiteSink returned error: %v", err)
+	}
+
+	if err := s.Write(testProducts); err != nil {
+		t.Fatalf("Write returned error: %v", err)
+	}
+	if !s.warnedDetail {
+		t.Error("sqliteSink did not set warnedDetail after writing a product with Detail set")
+	}
+
+	// Re-writing the same URL should upsert, not duplicate the row.
+	if err := s.Write([]scraper.Product{{URL: testProducts[0].URL, Name: "Product A (updated)"}}); err != nil {
+		t.Fatalf("Write (upsert) returned error: %v", err)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close returned error: %v", err)
+	}
+
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		t.Fatalf("failed to reopen sqlite db: %v", err)
+	}
+	defer db.Close()
+
+	var count int
+	if err := db.QueryRow("SELECT COUNT(*) FROM products").Scan(&count); err != nil {
+		t.Fatalf("failed to count rows: %v", err)
+	}
+	if count != len(testProducts) {
+		t.Errorf("got %d rows, want %d (upsert should not duplicate)", count, len(testProducts))
+	}
+
+	var name string
+	if err := db.QueryRow("SELECT name FROM products WHERE url = ?", testProducts[0].URL).Scan(&name); err != nil {
+		t.Fatalf("failed to query updated row: %v", err)
+	}
+	if name != "Product A (updated)" {
+		t.Errorf("name = %q, want upsert to have overwritten it to %q", name, "Product A (updated)")
+	}
+}
@@ -0,0 +1,74 @@
+package sink
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/daadaamed/Vigie-test/scraper"
+)
+
+// sqliteSink upserts products into a products table keyed on URL, so re-runs
+// update existing rows instead of duplicating them. It has no column for
+// Product.Detail (-depth=2); use .ndjson or .json if you need that data.
+type sqliteSink struct {
+	db           *sql.DB
+	warnedDetail bool
+}
+
+func newSQLiteSink(path string) (*sqliteSink, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, fmt.Errorf("sqlite sink: %w", err)
+	}
+
+	_, err = db.Exec(`
+		CREATE TABLE IF NOT EXISTS products (
+			url          TEXT PRIMARY KEY,
+			name         TEXT,
+			image        TEXT,
+			price        TEXT,
+			rating_avg   REAL,
+			rating_count INTEGER,
+			updated_at   TEXT
+		)`)
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("sqlite sink: %w", err)
+	}
+
+	return &sqliteSink{db: db}, nil
+}
+
+func (s *sqliteSink) Write(products []scraper.Product) error {
+	now := time.Now().UTC().Format(time.RFC3339)
+	for _, p := range products {
+		if p.Detail != nil && !s.warnedDetail {
+			log.Printf("sqlite sink: -depth=2 detail fields have no products column and will not be written; use .ndjson or .json to keep them")
+			s.warnedDetail = true
+		}
+
+		_, err := s.db.Exec(`
+			INSERT INTO products (url, name, image, price, rating_avg, rating_count, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?)
+			ON CONFLICT(url) DO UPDATE SET
+				name=excluded.name,
+				image=excluded.image,
+				price=excluded.price,
+				rating_avg=excluded.rating_avg,
+				rating_count=excluded.rating_count,
+				updated_at=excluded.updated_at`,
+			p.URL, p.Name, p.Image, p.Price, p.RatingAvg, p.RatingCount, now)
+		if err != nil {
+			return fmt.Errorf("sqlite sink: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *sqliteSink) Close() error {
+	return s.db.Close()
+}